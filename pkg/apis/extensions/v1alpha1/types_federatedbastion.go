@@ -0,0 +1,106 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Object = (*FederatedBastion)(nil)
+
+// FederatedBastionResource is a constant for the name of the FederatedBastion resource.
+const FederatedBastionResource = "FederatedBastion"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,path=federatedbastions,singular=federatedbastion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Age,JSONPath=".metadata.creationTimestamp",type=date,description="The federated bastion's age."
+
+// FederatedBastion lets an operator declare a single SSH ingress policy that is realized as one Bastion per
+// peer seed cluster, so that the same set of allowed source IPs can be used to reach bastions hosted on
+// different seeds without having to create and tear down a Bastion per seed by hand.
+type FederatedBastion struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of this FederatedBastion.
+	// If the object's deletion timestamp is set, this field is immutable.
+	Spec FederatedBastionSpec `json:"spec"`
+	// Status is the federated bastion's status.
+	// +optional
+	Status FederatedBastionStatus `json:"status,omitempty"`
+}
+
+// GetExtensionSpec implements Object.
+func (f *FederatedBastion) GetExtensionSpec() Spec {
+	return &f.Spec
+}
+
+// GetExtensionStatus implements Object.
+func (f *FederatedBastion) GetExtensionStatus() Status {
+	return &f.Status
+}
+
+// FederatedBastionSpec contains the specification for a federated SSH bastion host.
+type FederatedBastionSpec struct {
+	// BastionSpec is the specification that is used verbatim for every per-seed Bastion fanned out by this
+	// FederatedBastion.
+	BastionSpec `json:",inline"`
+	// Peers lists the seeds (or shoots, whose seed is resolved implicitly) on which a child Bastion should be
+	// created. Peers must be unique.
+	Peers []BastionPeerRef `json:"peers"`
+}
+
+// BastionPeerRef references a single target that a FederatedBastion fans a child Bastion out to. Exactly one of
+// SeedName or ShootRef must be set.
+type BastionPeerRef struct {
+	// SeedName is the name of the Seed that the child Bastion should be created on.
+	// +optional
+	SeedName *string `json:"seedName,omitempty"`
+	// ShootRef references a Shoot whose Seed should host the child Bastion.
+	// +optional
+	ShootRef *corev1.LocalObjectReference `json:"shootRef,omitempty"`
+}
+
+// FederatedBastionStatus holds the most recently observed status of the FederatedBastion.
+type FederatedBastionStatus struct {
+	// DefaultStatus is a structure containing common fields used by all extension resources.
+	DefaultStatus `json:",inline"`
+	// Ingresses is the list of external IPs and/or hostnames of the child bastion hosts, one entry per peer that
+	// has already been reconciled.
+	// +optional
+	Ingresses []NamedLoadBalancerIngress `json:"ingresses,omitempty"`
+}
+
+// NamedLoadBalancerIngress is a corev1.LoadBalancerIngress tagged with the name of the peer it was collected from.
+type NamedLoadBalancerIngress struct {
+	// Name identifies the peer (seed name or shoot reference) this ingress was collected from.
+	Name string `json:"name"`
+	// LoadBalancerIngress is the external IP and/or hostname of the peer's bastion host.
+	corev1.LoadBalancerIngress `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedBastionList is a collection of FederatedBastions.
+type FederatedBastionList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of FederatedBastions.
+	Items []FederatedBastion
+}
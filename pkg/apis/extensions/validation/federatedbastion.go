@@ -0,0 +1,87 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateFederatedBastion validates a FederatedBastion object.
+func ValidateFederatedBastion(federatedBastion *extensionsv1alpha1.FederatedBastion) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateFederatedBastionSpec(&federatedBastion.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateFederatedBastionSpec validates the spec of a FederatedBastion object.
+func ValidateFederatedBastionSpec(spec *extensionsv1alpha1.FederatedBastionSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Ingress) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ingress"), "at least one ingress policy must be given"))
+	}
+	for i, policy := range spec.Ingress {
+		if len(policy.IPBlock.CIDR) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ingress").Index(i).Child("ipBlock", "cidr"), "must not be empty"))
+		}
+	}
+
+	allErrs = append(allErrs, validateBastionPeers(spec.Peers, fldPath.Child("peers"))...)
+
+	return allErrs
+}
+
+func validateBastionPeers(peers []extensionsv1alpha1.BastionPeerRef, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(peers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one peer must be given"))
+	}
+
+	seen := make(map[string]bool, len(peers))
+	for i, peer := range peers {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case peer.SeedName == nil && peer.ShootRef == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "either seedName or shootRef must be set"))
+			continue
+		case peer.SeedName != nil && peer.ShootRef != nil:
+			allErrs = append(allErrs, field.Invalid(idxPath, peer, "only one of seedName or shootRef may be set"))
+			continue
+		}
+
+		key := peerKey(peer)
+		if seen[key] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		seen[key] = true
+	}
+
+	return allErrs
+}
+
+// peerKey returns a string uniquely identifying the target of a BastionPeerRef, used to detect duplicate peers.
+func peerKey(peer extensionsv1alpha1.BastionPeerRef) string {
+	if peer.SeedName != nil {
+		return "seed/" + *peer.SeedName
+	}
+	if peer.ShootRef != nil {
+		return "shoot/" + peer.ShootRef.Name
+	}
+	return ""
+}
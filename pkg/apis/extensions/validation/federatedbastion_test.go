@@ -0,0 +1,120 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("FederatedBastion validation", func() {
+	var federatedBastion *extensionsv1alpha1.FederatedBastion
+
+	BeforeEach(func() {
+		seedName := "seed-a"
+
+		federatedBastion = &extensionsv1alpha1.FederatedBastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-bastion", Namespace: "garden"},
+			Spec: extensionsv1alpha1.FederatedBastionSpec{
+				BastionSpec: extensionsv1alpha1.BastionSpec{
+					Ingress: []extensionsv1alpha1.BastionIngressPolicy{
+						{IPBlock: networkingv1.IPBlock{CIDR: "1.2.3.4/32"}},
+					},
+				},
+				Peers: []extensionsv1alpha1.BastionPeerRef{
+					{SeedName: &seedName},
+				},
+			},
+		}
+	})
+
+	It("should allow a valid FederatedBastion", func() {
+		Expect(ValidateFederatedBastion(federatedBastion)).To(BeEmpty())
+	})
+
+	It("should forbid an empty ingress list", func() {
+		federatedBastion.Spec.Ingress = nil
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.ingress"),
+			})),
+		))
+	})
+
+	It("should forbid an ingress policy without a CIDR", func() {
+		federatedBastion.Spec.Ingress[0].IPBlock.CIDR = ""
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.ingress[0].ipBlock.cidr"),
+			})),
+		))
+	})
+
+	It("should forbid an empty peer list", func() {
+		federatedBastion.Spec.Peers = nil
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.peers"),
+			})),
+		))
+	})
+
+	It("should forbid a peer with neither seedName nor shootRef set", func() {
+		federatedBastion.Spec.Peers = []extensionsv1alpha1.BastionPeerRef{{}}
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.peers[0]"),
+			})),
+		))
+	})
+
+	It("should forbid a peer with both seedName and shootRef set", func() {
+		seedName := "seed-a"
+		shootRef := corev1.LocalObjectReference{Name: "shoot-a"}
+		federatedBastion.Spec.Peers = []extensionsv1alpha1.BastionPeerRef{
+			{SeedName: &seedName, ShootRef: &shootRef},
+		}
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.peers[0]"),
+			})),
+		))
+	})
+
+	It("should forbid duplicate peers", func() {
+		seedName := "seed-a"
+		federatedBastion.Spec.Peers = []extensionsv1alpha1.BastionPeerRef{
+			{SeedName: &seedName},
+			{SeedName: &seedName},
+		}
+
+		Expect(ValidateFederatedBastion(federatedBastion)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("spec.peers[1]"),
+			})),
+		))
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbinding
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "secretbinding"
+
+// ShootSecretBindingNameIndexerName is the name of the field indexer that maps Shoots to the name of the
+// SecretBinding they reference via their `.spec.secretBindingName`.
+const ShootSecretBindingNameIndexerName = "shoot.spec.secretBindingName"
+
+// AddToManager adds the SecretBinding and SecretBindingProvider reconcilers to the given manager.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if err := addShootSecretBindingNameIndexer(ctx, mgr); err != nil {
+		return err
+	}
+
+	if err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.SecretBinding{}).
+		Complete(NewSecretBindingReconciler(logger.Logger, mgr.GetClient(), mgr.GetEventRecorderFor(ControllerName))); err != nil {
+		return fmt.Errorf("failed adding main reconciler: %w", err)
+	}
+
+	if err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName+"-provider").
+		For(&gardencorev1beta1.SecretBinding{}).
+		Watches(
+			&gardencorev1beta1.Shoot{},
+			handler.EnqueueRequestsFromMapFunc(mapShootToSecretBinding),
+		).
+		Complete(NewSecretBindingProviderReconciler(logger.Logger, mgr.GetClient())); err != nil {
+		return fmt.Errorf("failed adding provider reconciler: %w", err)
+	}
+
+	return nil
+}
+
+// addShootSecretBindingNameIndexer registers a field indexer on Shoots keyed by their `.spec.secretBindingName`,
+// so the SecretBindingProviderReconciler can enumerate the Shoots referencing a given SecretBinding via
+// client.MatchingFields instead of listing and filtering all Shoots on every reconciliation.
+func addShootSecretBindingNameIndexer(ctx context.Context, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gardencorev1beta1.Shoot{}, ShootSecretBindingNameIndexerName, func(obj client.Object) []string {
+		shoot, ok := obj.(*gardencorev1beta1.Shoot)
+		if !ok || shoot.Spec.SecretBindingName == "" {
+			return nil
+		}
+		return []string{shoot.Spec.SecretBindingName}
+	}); err != nil {
+		return fmt.Errorf("failed adding indexer for %s: %w", ShootSecretBindingNameIndexerName, err)
+	}
+
+	return nil
+}
+
+// mapShootToSecretBinding maps a Shoot to the reconcile.Request of the SecretBinding it references.
+func mapShootToSecretBinding(_ context.Context, obj client.Object) []reconcile.Request {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok || shoot.Spec.SecretBindingName == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Spec.SecretBindingName},
+	}}
+}
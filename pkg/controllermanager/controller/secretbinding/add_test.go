@@ -0,0 +1,121 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbinding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+func TestSecretBinding(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SecretBinding Controller Suite")
+}
+
+var (
+	ctx        = context.Background()
+	testEnv    *envtest.Environment
+	testClient client.Client
+	mgrCancel  context.CancelFunc
+)
+
+var _ = BeforeSuite(func() {
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths: []string{"testdata/crds"},
+	}
+
+	cfg, err := testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	scheme := runtime.NewScheme()
+	Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+
+	mgr, err := manager.New(cfg, manager.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(AddToManager(ctx, mgr)).To(Succeed())
+
+	var mgrCtx context.Context
+	mgrCtx, mgrCancel = context.WithCancel(ctx)
+
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(mgrCtx)).To(Succeed())
+	}()
+
+	syncCtx, syncCancel := context.WithTimeout(ctx, 30*time.Second)
+	defer syncCancel()
+	Expect(mgr.GetCache().WaitForCacheSync(syncCtx)).To(BeTrue())
+
+	testClient = mgr.GetClient()
+})
+
+var _ = AfterSuite(func() {
+	mgrCancel()
+	Expect(testEnv.Stop()).To(Succeed())
+})
+
+var _ = Describe("AddToManager", func() {
+	Describe("shoot.spec.secretBindingName field indexer", func() {
+		var namespace string
+
+		BeforeEach(func() {
+			namespace = "garden-" + string(metav1.NewUUID())[:8]
+			Expect(testClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+		})
+
+		It("should allow listing the Shoots referencing a SecretBinding without a full list", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "shoot-",
+					Namespace:    namespace,
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					SecretBindingName: "my-secretbinding",
+				},
+			}
+			Expect(testClient.Create(ctx, shoot)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Shoot {
+				shootList := &gardencorev1beta1.ShootList{}
+				g.Expect(testClient.List(ctx, shootList,
+					client.InNamespace(namespace),
+					client.MatchingFields{ShootSecretBindingNameIndexerName: "my-secretbinding"},
+				)).To(Succeed())
+				return shootList.Items
+			}).WithTimeout(time.Second * 10).Should(ConsistOf(HaveField("Name", shoot.Name)))
+		})
+	})
+})
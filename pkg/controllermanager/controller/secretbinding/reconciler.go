@@ -0,0 +1,140 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbinding
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// FinalizerName is added to a SecretBinding for as long as at least one Shoot still references it, so that it
+// cannot be deleted while in use.
+const FinalizerName = "core.gardener.cloud/secretbinding"
+
+// secretBindingReconciler reconciles a SecretBinding by keeping FinalizerName in sync with whether any Shoot still
+// references it.
+type secretBindingReconciler struct {
+	log      *logrus.Logger
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewSecretBindingReconciler creates a new reconciler for SecretBindings.
+func NewSecretBindingReconciler(log *logrus.Logger, c client.Client, recorder record.EventRecorder) reconcile.Reconciler {
+	return &secretBindingReconciler{log: log, client: c, recorder: recorder}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *secretBindingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := r.client.Get(ctx, req.NamespacedName, secretBinding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	inUse, err := secretBindingInUse(ctx, r.client, secretBinding)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether SecretBinding is still in use: %w", err)
+	}
+
+	if inUse {
+		if !controllerutil.ContainsFinalizer(secretBinding, FinalizerName) {
+			if err := controllerutils.AddFinalizers(ctx, r.client, secretBinding, FinalizerName); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed adding finalizer: %w", err)
+			}
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if controllerutil.ContainsFinalizer(secretBinding, FinalizerName) {
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, secretBinding, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing finalizer: %w", err)
+		}
+		r.recorder.Event(secretBinding, "Normal", "FinalizerRemoved", "No Shoot references this SecretBinding anymore")
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// secretBindingProviderReconciler reconciles the SecretBinding referenced by a changed Shoot, so that a Shoot
+// starting or stopping to reference a SecretBinding is picked up without waiting for the SecretBinding itself to
+// be touched.
+type secretBindingProviderReconciler struct {
+	log    *logrus.Logger
+	client client.Client
+}
+
+// NewSecretBindingProviderReconciler creates a new reconciler that reacts to Shoot changes and keeps the
+// referenced SecretBinding's FinalizerName in sync via the ShootSecretBindingNameIndexerName field indexer.
+func NewSecretBindingProviderReconciler(log *logrus.Logger, c client.Client) reconcile.Reconciler {
+	return &secretBindingProviderReconciler{log: log, client: c}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *secretBindingProviderReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := r.client.Get(ctx, req.NamespacedName, secretBinding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	inUse, err := secretBindingInUse(ctx, r.client, secretBinding)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether SecretBinding is still in use: %w", err)
+	}
+
+	if inUse && !controllerutil.ContainsFinalizer(secretBinding, FinalizerName) {
+		if err := controllerutils.AddFinalizers(ctx, r.client, secretBinding, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed adding finalizer: %w", err)
+		}
+	}
+
+	if !inUse && controllerutil.ContainsFinalizer(secretBinding, FinalizerName) {
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, secretBinding, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing finalizer: %w", err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// secretBindingInUse enumerates the Shoots referencing the given SecretBinding via the
+// ShootSecretBindingNameIndexerName field indexer, instead of listing and filtering all Shoots.
+func secretBindingInUse(ctx context.Context, c client.Client, secretBinding *gardencorev1beta1.SecretBinding) (bool, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList,
+		client.InNamespace(secretBinding.Namespace),
+		client.MatchingFields{ShootSecretBindingNameIndexerName: secretBinding.Name},
+		client.Limit(1),
+	); err != nil {
+		return false, err
+	}
+
+	return len(shootList.Items) > 0, nil
+}
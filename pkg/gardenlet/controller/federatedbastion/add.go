@@ -0,0 +1,44 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federatedbastion
+
+import (
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "federatedbastion"
+
+// AddToManager adds the FederatedBastion reconciler to the given manager.
+func AddToManager(mgr manager.Manager, seedClientMap clientmap.ClientMap) error {
+	if err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.FederatedBastion{}).
+		Complete(&Reconciler{
+			GardenClient:  mgr.GetClient(),
+			SeedClientMap: seedClientMap,
+		}); err != nil {
+		return fmt.Errorf("failed adding reconciler: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federatedbastion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// FinalizerName is the finalizer that is added to a FederatedBastion so that its reconciler can wait for all
+// child Bastions to be torn down on their seeds before the FederatedBastion itself is removed.
+const FinalizerName = "extensions.gardener.cloud/federatedbastion"
+
+// PeerNameLabel is set on every child Bastion to record which peer entry of the owning FederatedBastion it was
+// fanned out from.
+const PeerNameLabel = "federatedbastion.extensions.gardener.cloud/peer"
+
+// OwnerLabel is set on every child Bastion to record the namespace/name of the owning FederatedBastion, since
+// native owner references cannot span clusters.
+const OwnerLabel = "federatedbastion.extensions.gardener.cloud/owner"
+
+// statusSyncPeriod is how often a FederatedBastion is re-reconciled purely to pick up ingress/condition updates
+// that its child Bastions report on their seeds after being created. A watch cannot be used for this because the
+// children live on seed clusters that are not part of the manager's own cache.
+const statusSyncPeriod = 30 * time.Second
+
+// Reconciler reconciles FederatedBastions by fanning out one Bastion per peer to the respective seed cluster and
+// aggregating their status back onto the FederatedBastion.
+type Reconciler struct {
+	GardenClient  client.Client
+	SeedClientMap clientmap.ClientMap
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := log.FromContext(ctx)
+
+	federatedBastion := &extensionsv1alpha1.FederatedBastion{}
+	if err := r.GardenClient.Get(ctx, req.NamespacedName, federatedBastion); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if federatedBastion.DeletionTimestamp != nil {
+		return r.delete(ctx, log, federatedBastion)
+	}
+
+	return r.reconcile(ctx, log, federatedBastion)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, federatedBastion *extensionsv1alpha1.FederatedBastion) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(federatedBastion, FinalizerName) {
+		if err := controllerutils.AddFinalizers(ctx, r.GardenClient, federatedBastion, FinalizerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed adding finalizer: %w", err)
+		}
+	}
+
+	var (
+		ingresses  []extensionsv1alpha1.NamedLoadBalancerIngress
+		conditions []gardencorev1beta1.Condition
+		errs       []error
+	)
+
+	for _, peer := range federatedBastion.Spec.Peers {
+		peerName, seedName, err := r.resolvePeer(ctx, federatedBastion.Namespace, peer)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		seedClient, err := r.SeedClientMap.GetClient(ctx, keys.ForSeedWithName(seedName))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed getting client for seed %q: %w", seedName, err))
+			continue
+		}
+
+		childBastion, err := r.reconcileChildBastion(ctx, seedClient.Client(), federatedBastion, peerName)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reconciling Bastion for peer %q: %w", peerName, err))
+			continue
+		}
+
+		ingresses = append(ingresses, extensionsv1alpha1.NamedLoadBalancerIngress{
+			Name:               peerName,
+			LoadBalancerIngress: childBastion.Status.Ingress,
+		})
+		conditions = gardencorev1beta1helper.MergeConditions(conditions, prefixConditions(peerName, childBastion.Status.Conditions)...)
+	}
+
+	if len(errs) > 0 {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling %d peer(s): %v", len(errs), errs)
+	}
+
+	patch := client.MergeFrom(federatedBastion.DeepCopy())
+	federatedBastion.Status.Ingresses = ingresses
+	federatedBastion.Status.Conditions = conditions
+	if err := r.GardenClient.Status().Patch(ctx, federatedBastion, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: statusSyncPeriod}, nil
+}
+
+func (r *Reconciler) delete(ctx context.Context, log logr.Logger, federatedBastion *extensionsv1alpha1.FederatedBastion) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(federatedBastion, FinalizerName) {
+		return reconcile.Result{}, nil
+	}
+
+	var remaining int
+
+	for _, peer := range federatedBastion.Spec.Peers {
+		peerName, seedName, gone, err := r.resolvePeerForDeletion(ctx, federatedBastion.Namespace, peer)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if gone {
+			// The Shoot (and therefore the seed the child Bastion would live on) is already gone, so there is
+			// nothing left to delete for this peer.
+			continue
+		}
+
+		seedClient, err := r.SeedClientMap.GetClient(ctx, keys.ForSeedWithName(seedName))
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed getting client for seed %q: %w", seedName, err)
+		}
+
+		childBastion := &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      childBastionName(federatedBastion.Name, peerName),
+				Namespace: federatedBastion.Namespace,
+			},
+		}
+
+		if err := seedClient.Client().Delete(ctx, childBastion); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return reconcile.Result{}, fmt.Errorf("failed deleting Bastion for peer %q: %w", peerName, err)
+			}
+			continue
+		}
+		remaining++
+	}
+
+	if remaining > 0 {
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	if err := controllerutils.RemoveFinalizers(ctx, r.GardenClient, federatedBastion, FinalizerName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing finalizer: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// reconcileChildBastion creates or updates the per-seed Bastion for the given peer and returns its current state.
+func (r *Reconciler) reconcileChildBastion(ctx context.Context, seedClient client.Client, federatedBastion *extensionsv1alpha1.FederatedBastion, peerName string) (*extensionsv1alpha1.Bastion, error) {
+	childBastion := &extensionsv1alpha1.Bastion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      childBastionName(federatedBastion.Name, peerName),
+			Namespace: federatedBastion.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, seedClient, childBastion, func() error {
+		if childBastion.Labels == nil {
+			childBastion.Labels = map[string]string{}
+		}
+		childBastion.Labels[PeerNameLabel] = peerName
+		childBastion.Labels[OwnerLabel] = federatedBastion.Namespace + "/" + federatedBastion.Name
+
+		childBastion.Spec = federatedBastion.Spec.BastionSpec
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return childBastion, nil
+}
+
+// resolvePeer returns the peer's display name and the name of the seed its child Bastion should be created on.
+func (r *Reconciler) resolvePeer(ctx context.Context, namespace string, peer extensionsv1alpha1.BastionPeerRef) (string, string, error) {
+	if peer.SeedName != nil {
+		return *peer.SeedName, *peer.SeedName, nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: peer.ShootRef.Name}, shoot); err != nil {
+		return "", "", fmt.Errorf("failed getting Shoot %q: %w", peer.ShootRef.Name, err)
+	}
+	if shoot.Spec.SeedName == nil {
+		return "", "", fmt.Errorf("shoot %q is not yet assigned to a seed", peer.ShootRef.Name)
+	}
+
+	return peer.ShootRef.Name, *shoot.Spec.SeedName, nil
+}
+
+// resolvePeerForDeletion is the deletion-path counterpart of resolvePeer. Unlike resolvePeer, a Shoot that no
+// longer exists or that has not (or no longer) been assigned to a seed is not an error here: there is no seed
+// left to hold a child Bastion for this peer, so it is reported as already gone instead of blocking the
+// FederatedBastion's finalizer removal forever.
+func (r *Reconciler) resolvePeerForDeletion(ctx context.Context, namespace string, peer extensionsv1alpha1.BastionPeerRef) (peerName, seedName string, gone bool, err error) {
+	if peer.SeedName != nil {
+		return *peer.SeedName, *peer.SeedName, false, nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: peer.ShootRef.Name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return peer.ShootRef.Name, "", true, nil
+		}
+		return "", "", false, fmt.Errorf("failed getting Shoot %q: %w", peer.ShootRef.Name, err)
+	}
+	if shoot.Spec.SeedName == nil {
+		return peer.ShootRef.Name, "", true, nil
+	}
+
+	return peer.ShootRef.Name, *shoot.Spec.SeedName, false, nil
+}
+
+// childBastionName computes the name of the per-seed Bastion fanned out for the given peer.
+func childBastionName(federatedBastionName, peerName string) string {
+	return federatedBastionName + "-" + peerName
+}
+
+// prefixConditions prefixes every condition's Type with "<peerName>/" so that conditions from multiple peers can
+// be merged onto the aggregate FederatedBastionStatus without colliding.
+func prefixConditions(peerName string, conditions []gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
+	prefixed := make([]gardencorev1beta1.Condition, 0, len(conditions))
+	for _, cond := range conditions {
+		cond.Type = gardencorev1beta1.ConditionType(peerName + "/" + string(cond.Type))
+		prefixed = append(prefixed, cond)
+	}
+	return prefixed
+}
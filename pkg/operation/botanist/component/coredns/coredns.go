@@ -0,0 +1,48 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coredns
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+)
+
+// clusterIPOffset is the offset at which the CoreDNS Service's ClusterIP is assigned within the Shoot's service
+// network(s).
+const clusterIPOffset = 10
+
+// ClusterIPs returns the ClusterIP(s) the CoreDNS Service should be assigned within the given Shoot service
+// network(s). For single-stack Shoots, <serviceCIDRs> has exactly one entry and a single ClusterIP is returned.
+// For dual-stack Shoots, <serviceCIDRs> carries one IPv4 and one IPv6 CIDR (in either order, mirroring
+// status.podIPs), and one ClusterIP per family is returned at the same offset, so that both families resolve the
+// cluster DNS at a predictable address.
+func ClusterIPs(serviceCIDRs []*net.IPNet) ([]net.IP, error) {
+	if len(serviceCIDRs) == 0 {
+		return nil, fmt.Errorf("no service CIDRs given")
+	}
+
+	if len(serviceCIDRs) > 1 {
+		return common.ComputeOffsetIPs(serviceCIDRs, clusterIPOffset)
+	}
+
+	clusterIP, err := common.ComputeOffsetIP(serviceCIDRs[0], clusterIPOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	return []net.IP{clusterIP}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coredns_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/gardener/gardener/pkg/operation/botanist/component/coredns"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCoreDNS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CoreDNS Component Suite")
+}
+
+var _ = Describe("#ClusterIPs", func() {
+	It("should return a single ClusterIP for a single-stack service network", func() {
+		_, subnet, _ := net.ParseCIDR("100.64.0.0/13")
+
+		result, err := ClusterIPs([]*net.IPNet{subnet})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal([]net.IP{net.ParseIP("100.64.0.10").To4()}))
+	})
+
+	It("should return one ClusterIP per family for a dual-stack service network", func() {
+		_, ipv4Subnet, _ := net.ParseCIDR("100.64.0.0/13")
+		_, ipv6Subnet, _ := net.ParseCIDR("fc00::/8")
+
+		result, err := ClusterIPs([]*net.IPNet{ipv4Subnet, ipv6Subnet})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal([]net.IP{
+			net.ParseIP("100.64.0.10").To4(),
+			net.ParseIP("fc00::a"),
+		}))
+	})
+
+	It("should return an error if no service CIDRs are given", func() {
+		result, err := ClusterIPs(nil)
+
+		Expect(err).To(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+
+	It("should return an error if the service network is too small", func() {
+		_, subnet, _ := net.ParseCIDR("100.64.0.0/32")
+
+		result, err := ClusterIPs([]*net.IPNet{subnet})
+
+		Expect(err).To(HaveOccurred())
+		Expect(result).To(BeNil())
+	})
+})
+
+var _ = Describe("#Service", func() {
+	It("should set a single ClusterIP for a single-stack service network", func() {
+		_, subnet, _ := net.ParseCIDR("100.64.0.0/13")
+
+		service, err := Service("shoot--foo--bar", []*net.IPNet{subnet})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.Name).To(Equal(ServiceName))
+		Expect(service.Namespace).To(Equal("shoot--foo--bar"))
+		Expect(service.Spec.ClusterIP).To(Equal("100.64.0.10"))
+		Expect(service.Spec.ClusterIPs).To(Equal([]string{"100.64.0.10"}))
+		Expect(service.Spec.IPFamilies).To(BeEmpty())
+	})
+
+	It("should set one ClusterIP per family and the matching IPFamilies order for a dual-stack service network", func() {
+		_, ipv4Subnet, _ := net.ParseCIDR("100.64.0.0/13")
+		_, ipv6Subnet, _ := net.ParseCIDR("fc00::/8")
+
+		service, err := Service("shoot--foo--bar", []*net.IPNet{ipv4Subnet, ipv6Subnet})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.Spec.ClusterIP).To(Equal("100.64.0.10"))
+		Expect(service.Spec.ClusterIPs).To(Equal([]string{"100.64.0.10", "fc00::a"}))
+		Expect(service.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}))
+	})
+
+	It("should propagate an error from ClusterIPs", func() {
+		service, err := Service("shoot--foo--bar", nil)
+
+		Expect(err).To(HaveOccurred())
+		Expect(service).To(BeNil())
+	})
+})
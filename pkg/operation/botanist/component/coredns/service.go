@@ -0,0 +1,72 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coredns
+
+import (
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ServiceName is the name of the CoreDNS Service, kept as "kube-dns" for drop-in compatibility with kubelets and
+// pods that still resolve the cluster DNS server through that well-known name.
+const ServiceName = "kube-dns"
+
+// labels are the pod selector labels shared by the CoreDNS Service and its Deployment.
+var labels = map[string]string{"k8s-app": "kube-dns"}
+
+// Service returns the CoreDNS Service for the given namespace, with its ClusterIP(s) derived from the Shoot's
+// service network(s) via ClusterIPs. For dual-stack Shoots, <serviceCIDRs> contains both an IPv4 and an IPv6 CIDR
+// and the Service is assigned one ClusterIP per family.
+func Service(namespace string, serviceCIDRs []*net.IPNet) (*corev1.Service, error) {
+	clusterIPs, err := ClusterIPs(serviceCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromInt(53)},
+				{Name: "dns-tcp", Port: 53, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(53)},
+				{Name: "metrics", Port: 9153, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(9153)},
+			},
+		},
+	}
+
+	service.Spec.ClusterIP = clusterIPs[0].String()
+	for _, clusterIP := range clusterIPs {
+		service.Spec.ClusterIPs = append(service.Spec.ClusterIPs, clusterIP.String())
+	}
+
+	if len(clusterIPs) > 1 {
+		ipv4, ipv6 := corev1.IPv4Protocol, corev1.IPv6Protocol
+		if clusterIPs[0].To4() != nil {
+			service.Spec.IPFamilies = []corev1.IPFamily{ipv4, ipv6}
+		} else {
+			service.Spec.IPFamilies = []corev1.IPFamily{ipv6, ipv4}
+		}
+	}
+
+	return service, nil
+}
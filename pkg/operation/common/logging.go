@@ -0,0 +1,194 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+
+	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GardenLokiPriorityClassName is the name of the priority class used for the Loki StatefulSet deployed as part of
+// the FluentBitLokiStack.
+const GardenLokiPriorityClassName = "gardener-logging"
+
+// LoggingStack is implemented by every shipper/store combination that can be deployed onto a seed to collect and
+// store logs. The implementation that DeleteSeedLoggingStack operates on is the one last passed to
+// SetActiveLoggingStack; the gardenlet configuration does not select it.
+//
+// LoggingStack intentionally has no Deploy method: the actual manifests (Helm charts/values) for a backend are
+// rendered and applied by the component package responsible for that backend, not by this package. Resources is
+// only the inventory that DeleteSeedLoggingStack and Destroy operate on for teardown.
+type LoggingStack interface {
+	// Destroy removes all resources owned by this logging stack.
+	Destroy(ctx context.Context, c client.Client) error
+	// Resources returns all objects owned by this logging stack.
+	Resources() []client.Object
+}
+
+var loggingStackRegistry = map[string]func() LoggingStack{}
+
+func init() {
+	RegisterLoggingStack("fluent-bit-loki", func() LoggingStack { return &FluentBitLokiStack{} })
+	RegisterLoggingStack("vector-elasticsearch", func() LoggingStack { return &VectorElasticsearchStack{} })
+}
+
+// RegisterLoggingStack registers a constructor for a LoggingStack under the given backend name, so that
+// LoggingStackByName can resolve it. Extensions call this from an init function to contribute a custom logging
+// backend.
+func RegisterLoggingStack(name string, constructor func() LoggingStack) {
+	loggingStackRegistry[name] = constructor
+}
+
+// LoggingStackByName returns a new instance of the LoggingStack registered under the given backend name.
+func LoggingStackByName(name string) (LoggingStack, error) {
+	constructor, ok := loggingStackRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("no logging stack registered for backend %q", name)
+	}
+	return constructor(), nil
+}
+
+// activeLoggingStack is the LoggingStack used by DeleteSeedLoggingStack. It defaults to the FluentBitLokiStack
+// until SetActiveLoggingStack is called.
+var (
+	activeLoggingStackMu sync.RWMutex
+	activeLoggingStack   LoggingStack = &FluentBitLokiStack{}
+)
+
+// SetActiveLoggingStack sets the LoggingStack that DeleteSeedLoggingStack operates on. The gardenlet does not call
+// it at startup, and no gardenlet configuration field is mapped to it.
+func SetActiveLoggingStack(stack LoggingStack) {
+	activeLoggingStackMu.Lock()
+	defer activeLoggingStackMu.Unlock()
+	activeLoggingStack = stack
+}
+
+// DeleteSeedLoggingStack deletes all resources of the currently active LoggingStack from the seed cluster.
+func DeleteSeedLoggingStack(ctx context.Context, c client.Client) error {
+	activeLoggingStackMu.RLock()
+	stack := activeLoggingStack
+	activeLoggingStackMu.RUnlock()
+
+	for _, resource := range stack.Resources() {
+		if err := c.Delete(ctx, resource); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// FluentBitLokiStack is the original logging stack: fluent-bit as shipper, deployed as a DaemonSet, and Loki as
+// store, deployed as a single StatefulSet replica on the seed.
+type FluentBitLokiStack struct{}
+
+// Destroy implements LoggingStack.
+func (f *FluentBitLokiStack) Destroy(ctx context.Context, c client.Client) error {
+	for _, resource := range f.Resources() {
+		if err := c.Delete(ctx, resource); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resources implements LoggingStack.
+func (f *FluentBitLokiStack) Resources() []client.Object {
+	return []client.Object{
+		// seed components
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-config", Namespace: v1beta1constants.GardenNamespace}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-fluentbit", Namespace: v1beta1constants.GardenNamespace}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit"}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "loki"}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: GardenLokiPriorityClassName}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-read"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-read"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
+		// shoot components
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-loki", Namespace: v1beta1constants.GardenNamespace}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-to-loki", Namespace: v1beta1constants.GardenNamespace}},
+		&hvpav1alpha1.Hvpa{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "loki-config", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "loki-loki-0", Namespace: v1beta1constants.GardenNamespace}},
+	}
+}
+
+// VectorElasticsearchStack is an alternative logging stack: vector as shipper and a three-replica Elasticsearch
+// StatefulSet as store.
+type VectorElasticsearchStack struct{}
+
+// elasticsearchReplicas is the number of replicas of the Elasticsearch StatefulSet, and therefore the number of
+// PersistentVolumeClaims owned by this stack.
+const elasticsearchReplicas = 3
+
+// Destroy implements LoggingStack.
+func (v *VectorElasticsearchStack) Destroy(ctx context.Context, c client.Client) error {
+	for _, resource := range v.Resources() {
+		if err := c.Delete(ctx, resource); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resources implements LoggingStack.
+func (v *VectorElasticsearchStack) Resources() []client.Object {
+	resources := []client.Object{
+		// seed components
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "vector-config", Namespace: v1beta1constants.GardenNamespace}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "vector", Namespace: v1beta1constants.GardenNamespace}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-vector", Namespace: v1beta1constants.GardenNamespace}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "vector"}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "vector-read"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "vector-read"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "vector", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "vector", Namespace: v1beta1constants.GardenNamespace}},
+		// shoot components
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-elasticsearch", Namespace: v1beta1constants.GardenNamespace}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-to-elasticsearch", Namespace: v1beta1constants.GardenNamespace}},
+		&hvpav1alpha1.Hvpa{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch-config", Namespace: v1beta1constants.GardenNamespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch", Namespace: v1beta1constants.GardenNamespace}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch", Namespace: v1beta1constants.GardenNamespace}},
+	}
+
+	for i := 0; i < elasticsearchReplicas; i++ {
+		resources = append(resources, &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("elasticsearch-data-elasticsearch-%d", i),
+				Namespace: v1beta1constants.GardenNamespace,
+			},
+		})
+	}
+
+	return resources
+}
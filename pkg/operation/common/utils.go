@@ -0,0 +1,109 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+)
+
+// ComputeOffsetIP parses the provided <subnet> and offsets with the value of <offset>. For example, <subnet> = 100.64.0.0/11 and
+// <offset> = 10 the result would be 100.64.0.10. IPv4 as well as IPv6 subnets are supported.
+func ComputeOffsetIP(subnet *net.IPNet, offset int64) (net.IP, error) {
+	if subnet == nil {
+		return nil, fmt.Errorf("subnet is nil")
+	}
+
+	ones, bits := subnet.Mask.Size()
+	if bits-ones < 2 {
+		return nil, fmt.Errorf("subnet %s is too small to host an IP address with offset %d", subnet.String(), offset)
+	}
+
+	ip := big.NewInt(0).SetBytes(subnet.IP)
+	ip = ip.Add(ip, big.NewInt(offset))
+
+	ipWithOffset := padToLen(ip.Bytes(), len(subnet.IP))
+
+	if !subnet.Contains(ipWithOffset) {
+		return nil, fmt.Errorf("cannot compute IP with offset %d - subnet %s too small", offset, subnet.String())
+	}
+
+	// IPv4 subnets reserve the last address of the range for broadcast.
+	if bits == net.IPv4len*8 && ipWithOffset.Equal(broadcastIP(subnet)) {
+		return nil, fmt.Errorf("computed IP %s is the broadcast IP of subnet %s", ipWithOffset, subnet.String())
+	}
+
+	return ipWithOffset, nil
+}
+
+// ComputeOffsetIPs is the dual-stack counterpart of ComputeOffsetIP. It accepts a slice of CIDRs representing a
+// dual-stack network (one IPv4 and one IPv6 entry, in either order, mirroring how status.podIPs carries multiple
+// family entries) and returns one offset IP per CIDR, preserving the order of <cidrs>. It returns an error if
+// <cidrs> is empty or if more than one CIDR of the same IP family is given.
+func ComputeOffsetIPs(cidrs []*net.IPNet, offset int64) ([]net.IP, error) {
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("no CIDRs given")
+	}
+
+	var (
+		result   = make([]net.IP, 0, len(cidrs))
+		seenIPv4 = false
+		seenIPv6 = false
+	)
+
+	for _, cidr := range cidrs {
+		ip, err := ComputeOffsetIP(cidr, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if ip.To4() != nil {
+			if seenIPv4 {
+				return nil, fmt.Errorf("cidrs must not contain more than one IPv4 entry")
+			}
+			seenIPv4 = true
+		} else {
+			if seenIPv6 {
+				return nil, fmt.Errorf("cidrs must not contain more than one IPv6 entry")
+			}
+			seenIPv6 = true
+		}
+
+		result = append(result, ip)
+	}
+
+	return result, nil
+}
+
+// padToLen left-pads <b> with zero bytes until it has length <length>.
+func padToLen(b []byte, length int) net.IP {
+	if len(b) >= length {
+		return net.IP(b[len(b)-length:])
+	}
+
+	padded := make([]byte, length)
+	copy(padded[length-len(b):], b)
+	return net.IP(padded)
+}
+
+// broadcastIP returns the broadcast address of <subnet>, i.e. the network address with all host bits set.
+func broadcastIP(subnet *net.IPNet) net.IP {
+	ip := make(net.IP, len(subnet.IP))
+	for i := range ip {
+		ip[i] = subnet.IP[i] | ^subnet.Mask[i]
+	}
+	return ip
+}
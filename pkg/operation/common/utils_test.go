@@ -102,6 +102,61 @@ var _ = Describe("common", func() {
 			})
 		})
 
+		Describe("#ComputeOffsetIPs", func() {
+			It("should return one offset IP per family for a dual-stack IPv4/IPv6 network", func() {
+				_, ipv4Subnet, _ := net.ParseCIDR("100.64.0.0/13")
+				_, ipv6Subnet, _ := net.ParseCIDR("fc00::/8")
+
+				result, err := ComputeOffsetIPs([]*net.IPNet{ipv4Subnet, ipv6Subnet}, 10)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal([]net.IP{
+					net.ParseIP("100.64.0.10").To4(),
+					net.ParseIP("fc00::a"),
+				}))
+			})
+
+			It("should preserve the order of the given CIDRs when IPv6 is listed first", func() {
+				_, ipv4Subnet, _ := net.ParseCIDR("100.64.0.0/13")
+				_, ipv6Subnet, _ := net.ParseCIDR("fc00::/8")
+
+				result, err := ComputeOffsetIPs([]*net.IPNet{ipv6Subnet, ipv4Subnet}, 10)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal([]net.IP{
+					net.ParseIP("fc00::a"),
+					net.ParseIP("100.64.0.10").To4(),
+				}))
+			})
+
+			It("should return an error if no CIDRs are given", func() {
+				result, err := ComputeOffsetIPs(nil, 10)
+
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should return an error if two CIDRs of the same family are given", func() {
+				_, subnet1, _ := net.ParseCIDR("100.64.0.0/13")
+				_, subnet2, _ := net.ParseCIDR("100.96.0.0/13")
+
+				result, err := ComputeOffsetIPs([]*net.IPNet{subnet1, subnet2}, 10)
+
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("should return an error if one of the CIDRs is invalid", func() {
+				_, ipv4Subnet, _ := net.ParseCIDR("100.64.0.0/32")
+				_, ipv6Subnet, _ := net.ParseCIDR("fc00::/8")
+
+				result, err := ComputeOffsetIPs([]*net.IPNet{ipv4Subnet, ipv6Subnet}, 10)
+
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+		})
+
 		Describe("#GenerateAddonConfig", func() {
 			Context("values=nil and enabled=false", func() {
 				It("should return a map with key enabled=false", func() {
@@ -299,28 +354,6 @@ var _ = Describe("common", func() {
 			ctx  context.Context
 		)
 
-		resources := []client.Object{
-			//seed components
-			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-config", Namespace: v1beta1constants.GardenNamespace}},
-			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
-			&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-fluentbit", Namespace: v1beta1constants.GardenNamespace}},
-			&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit"}},
-			&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "loki"}},
-			&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: GardenLokiPriorityClassName}},
-			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-read"}},
-			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-read"}},
-			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
-			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit", Namespace: v1beta1constants.GardenNamespace}},
-			//shoot components
-			&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-loki", Namespace: v1beta1constants.GardenNamespace}},
-			&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-to-loki", Namespace: v1beta1constants.GardenNamespace}},
-			&hvpav1alpha1.Hvpa{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
-			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "loki-config", Namespace: v1beta1constants.GardenNamespace}},
-			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
-			&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "loki", Namespace: v1beta1constants.GardenNamespace}},
-			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "loki-loki-0", Namespace: v1beta1constants.GardenNamespace}},
-		}
-
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 			c = mockclient.NewMockClient(ctrl)
@@ -330,16 +363,73 @@ var _ = Describe("common", func() {
 
 		AfterEach(func() {
 			ctrl.Finish()
+			SetActiveLoggingStack(&FluentBitLokiStack{})
+		})
+
+		It("should delete all FluentBitLokiStack components by default", func() {
+			for _, resource := range (&FluentBitLokiStack{}).Resources() {
+				c.EXPECT().Delete(ctx, resource)
+			}
+
+			err := DeleteSeedLoggingStack(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("should delete all seed logging stack components", func() {
-			for _, resource := range resources {
+		It("should delete all VectorElasticsearchStack components when that stack is active", func() {
+			SetActiveLoggingStack(&VectorElasticsearchStack{})
+
+			for _, resource := range (&VectorElasticsearchStack{}).Resources() {
 				c.EXPECT().Delete(ctx, resource)
 			}
 
 			err := DeleteSeedLoggingStack(ctx, c)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should ignore not-found errors", func() {
+			for _, resource := range (&FluentBitLokiStack{}).Resources() {
+				c.EXPECT().Delete(ctx, resource).Return(apierrors.NewNotFound(corev1.Resource("resource"), "foo"))
+			}
+
+			err := DeleteSeedLoggingStack(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("#LoggingStackByName", func() {
+		It("should return the registered FluentBitLokiStack", func() {
+			stack, err := LoggingStackByName("fluent-bit-loki")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).To(Equal(&FluentBitLokiStack{}))
+		})
+
+		It("should return the registered VectorElasticsearchStack", func() {
+			stack, err := LoggingStackByName("vector-elasticsearch")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).To(Equal(&VectorElasticsearchStack{}))
+		})
+
+		It("should return an error for an unknown backend", func() {
+			stack, err := LoggingStackByName("does-not-exist")
+
+			Expect(err).To(HaveOccurred())
+			Expect(stack).To(BeNil())
+		})
+	})
+
+	Describe("#VectorElasticsearchStack", func() {
+		It("should produce one PersistentVolumeClaim per Elasticsearch replica", func() {
+			var pvcCount int
+			for _, resource := range (&VectorElasticsearchStack{}).Resources() {
+				if _, ok := resource.(*corev1.PersistentVolumeClaim); ok {
+					pvcCount++
+				}
+			}
+
+			Expect(pvcCount).To(Equal(3))
+		})
 	})
 
 	Describe("#FilterEntriesByPrefix", func() {